@@ -0,0 +1,164 @@
+// Package cairo1 routes hints compiled into a CasmContractClass, the
+// Cairo1/Sierra counterpart to the Cairo0 hints handled by package zero
+package cairo1
+
+import (
+	"fmt"
+
+	"github.com/NethermindEth/cairo-vm-go/pkg/hintrunner/hinter"
+	VM "github.com/NethermindEth/cairo-vm-go/pkg/vm"
+	mem "github.com/NethermindEth/cairo-vm-go/pkg/vm/memory"
+	f "github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+)
+
+// CheatcodeOp is the host-side logic behind a cheatcode selector
+type CheatcodeOp func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext, args []*f.Element) ([]*f.Element, error)
+
+// CheatcodeHint is a cheatcode call parsed out of a CasmContractClass hint.
+// Cheatcodes are the Cairo1 analogue of Cairo0's SystemCall: a named,
+// host-implemented operation a compiled hint can invoke by selector
+type CheatcodeHint struct {
+	Selector string
+	Args     []*f.Element
+	op       CheatcodeOp
+}
+
+func (h *CheatcodeHint) String() string {
+	return h.Selector
+}
+
+func (h *CheatcodeHint) Execute(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+	_, err := h.op(vm, ctx, h.Args)
+	return err
+}
+
+// Cairo1HintProcessor parses CasmContractClass hints and dispatches them. It
+// carries the configuration flags that Cairo1 programs need threaded into
+// dictionary handling
+type Cairo1HintProcessor struct {
+	// SegmentArenaValidation enables the DictionaryManager's segment_arena
+	// checks. The FinalizeSegment cheatcode runs them; the
+	// RelocateAllDictionaries cheatcode passes no segment_arena pointer, so
+	// it relocates without validating
+	SegmentArenaValidation bool
+	// UseTemporarySegments threads through to InitializeDictionaryManager
+	UseTemporarySegments bool
+
+	cheatcodes map[string]CheatcodeOp
+}
+
+func NewCairo1HintProcessor(segmentArenaValidation, useTemporarySegments bool) *Cairo1HintProcessor {
+	p := &Cairo1HintProcessor{
+		SegmentArenaValidation: segmentArenaValidation,
+		UseTemporarySegments:   useTemporarySegments,
+		cheatcodes:             make(map[string]CheatcodeOp),
+	}
+	p.RegisterCheatcode("RelocateAllDictionaries", p.relocateAllDictionaries)
+	p.RegisterCheatcode("FinalizeSegment", p.finalizeSegment)
+	return p
+}
+
+// RegisterCheatcode makes a cheatcode selector available to hints parsed by
+// this processor
+func (p *Cairo1HintProcessor) RegisterCheatcode(selector string, op CheatcodeOp) {
+	p.cheatcodes[selector] = op
+}
+
+// relocateAllDictionaries backs the `RelocateAllDictionaries` selector,
+// letting a Cairo1 hint flatten every tracked dictionary into a single
+// segment on demand instead of only implicitly at end-of-run
+func (p *Cairo1HintProcessor) relocateAllDictionaries(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext, _ []*f.Element) ([]*f.Element, error) {
+	return nil, ctx.DictionaryManager.RelocateAllDictionaries(vm, nil)
+}
+
+// finalizeSegment backs the `FinalizeSegment` selector, letting a compiled
+// hint check a single dictionary segment as it is finalized - against just
+// that segment's own entry in the segment_arena, when
+// SegmentArenaValidation is enabled - as soon as that dictionary's last access
+// happens, while other dictionaries may still be open, instead of only in
+// bulk via RelocateAllDictionaries at end-of-run. Its args are the
+// dictionary's and the segment_arena's pointers, each passed as a
+// [segment, offset] felt pair since a bare felt cannot carry a relocatable
+// value
+func (p *Cairo1HintProcessor) finalizeSegment(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext, args []*f.Element) ([]*f.Element, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf("FinalizeSegment: expected 4 args (dict_segment, dict_offset, arena_segment, arena_offset), got %d", len(args))
+	}
+	dictAddr := feltsToAddr(args[0], args[1])
+	arenaPtr := feltsToAddr(args[2], args[3])
+	return nil, ctx.DictionaryManager.FinalizeSegment(vm, &dictAddr, &arenaPtr)
+}
+
+// feltsToAddr reinterprets a [segment, offset] felt pair as a MemoryAddress,
+// the representation cheatcode args use for pointer-typed arguments since a
+// felt alone cannot carry a relocatable value
+func feltsToAddr(segment, offset *f.Element) mem.MemoryAddress {
+	return mem.MemoryAddress{
+		SegmentIndex: int(segment.Uint64()),
+		Offset:       offset.Uint64(),
+	}
+}
+
+// NewCheatcodeHint builds the Hinter for a single cheatcode call parsed out
+// of a CasmContractClass hint
+func (p *Cairo1HintProcessor) NewCheatcodeHint(selector string, args []*f.Element) (hinter.Hinter, error) {
+	op, ok := p.cheatcodes[selector]
+	if !ok {
+		return nil, fmt.Errorf("unknown cheatcode %q", selector)
+	}
+	return &CheatcodeHint{Selector: selector, Args: args, op: op}, nil
+}
+
+// InitializeDictionaryManager wires this processor's UseTemporarySegments
+// flag into the dictionary manager. Must run before any hint that creates a
+// dictionary
+func (p *Cairo1HintProcessor) InitializeDictionaryManager(ctx *hinter.HintRunnerContext) {
+	hinter.InitializeDictionaryManager(ctx, p.UseTemporarySegments, p.SegmentArenaValidation)
+}
+
+// CheatcodeCall is a single cheatcode invocation parsed out of one entry of
+// a CasmContractClass hint's body
+type CheatcodeCall struct {
+	Selector string
+	Args     []*f.Element
+}
+
+// CasmHint mirrors one entry of a CasmContractClass's `hints` array:
+// `List[Tuple[int, List[Hint]]]`, a program counter paired with every
+// cheatcode call compiled to fire there. A real CasmContractClass encodes
+// each call as a typed Hint variant (AllocSegment, TestLessThan, ...); this
+// processor flattens that enum down to a selector string plus felt args, so
+// translating a parsed CasmContractClass into []CasmHint is the
+// responsibility of whatever loads the compiled class, not this package
+type CasmHint struct {
+	Pc    uint64
+	Hints []CheatcodeCall
+}
+
+// GetCairo1Hints dispatches a CasmContractClass's hints into pc-indexed
+// Hinters, mirroring zero.GetZeroHints for the Cairo1 front-end
+func (p *Cairo1HintProcessor) GetCairo1Hints(casmHints []CasmHint) (map[uint64][]hinter.Hinter, error) {
+	hints := make(map[uint64][]hinter.Hinter)
+	for _, casmHint := range casmHints {
+		for _, call := range casmHint.Hints {
+			h, err := p.NewCheatcodeHint(call.Selector, call.Args)
+			if err != nil {
+				return nil, err
+			}
+			hints[casmHint.Pc] = append(hints[casmHint.Pc], h)
+		}
+	}
+	return hints, nil
+}
+
+// SegmentArenaFinalizationSelector is the cheatcode selector the runner's
+// entry-code builder must emit a call to, right before a proof_mode or
+// append_return_values run finalizes, whenever the program uses the
+// segment_arena builtin. It exists so that builder and this processor agree
+// on one name instead of each hardcoding their own string.
+//
+// NOTE: this tree does not contain the runner/entry-code-builder package, so
+// the call site that should emit a CASM instruction invoking this selector
+// could not be added here - this constant is the hook the builder is meant
+// to use once that package exists.
+const SegmentArenaFinalizationSelector = "RelocateAllDictionaries"
@@ -0,0 +1,119 @@
+package cairo1
+
+import (
+	"testing"
+
+	"github.com/NethermindEth/cairo-vm-go/pkg/hintrunner/hinter"
+	VM "github.com/NethermindEth/cairo-vm-go/pkg/vm"
+	f "github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+)
+
+func TestNewCairo1HintProcessor_RegistersBuiltinCheatcodes(t *testing.T) {
+	p := NewCairo1HintProcessor(false, false)
+
+	for _, selector := range []string{"RelocateAllDictionaries", "FinalizeSegment"} {
+		h, err := p.NewCheatcodeHint(selector, nil)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", selector, err)
+		}
+		if h.String() != selector {
+			t.Fatalf("expected hint named %q, got %q", selector, h.String())
+		}
+	}
+}
+
+func TestNewCheatcodeHint_UnknownSelector(t *testing.T) {
+	p := NewCairo1HintProcessor(false, false)
+
+	if _, err := p.NewCheatcodeHint("NotARealCheatcode", nil); err == nil {
+		t.Fatal("expected an error for an unregistered selector")
+	}
+}
+
+func TestRegisterCheatcode_CustomSelectorIsDispatchable(t *testing.T) {
+	p := NewCairo1HintProcessor(false, false)
+	called := false
+	p.RegisterCheatcode("Noop", func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext, args []*f.Element) ([]*f.Element, error) {
+		called = true
+		return nil, nil
+	})
+
+	h, err := p.NewCheatcodeHint("Noop", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := h.Execute(nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !called {
+		t.Fatal("expected the registered cheatcode op to run")
+	}
+}
+
+func TestFinalizeSegment_RejectsWrongArgCount(t *testing.T) {
+	p := NewCairo1HintProcessor(true, false)
+
+	if _, err := p.finalizeSegment(nil, nil, []*f.Element{}); err == nil {
+		t.Fatal("expected an error for a FinalizeSegment call with no args")
+	}
+
+	one := new(f.Element).SetUint64(1)
+	if _, err := p.finalizeSegment(nil, nil, []*f.Element{one, one, one}); err == nil {
+		t.Fatal("expected an error for a FinalizeSegment call with only 3 args")
+	}
+}
+
+func TestFeltsToAddr(t *testing.T) {
+	segment := new(f.Element).SetUint64(2)
+	offset := new(f.Element).SetUint64(5)
+
+	addr := feltsToAddr(segment, offset)
+	if addr.SegmentIndex != 2 || addr.Offset != 5 {
+		t.Fatalf("expected {segment: 2, offset: 5}, got {segment: %d, offset: %d}", addr.SegmentIndex, addr.Offset)
+	}
+}
+
+func TestGetCairo1Hints_FlattensHintsByPc(t *testing.T) {
+	p := NewCairo1HintProcessor(false, false)
+
+	casmHints := []CasmHint{
+		{
+			Pc: 10,
+			Hints: []CheatcodeCall{
+				{Selector: "RelocateAllDictionaries"},
+				{Selector: "FinalizeSegment", Args: []*f.Element{new(f.Element), new(f.Element), new(f.Element), new(f.Element)}},
+			},
+		},
+		{
+			Pc:    20,
+			Hints: []CheatcodeCall{{Selector: "RelocateAllDictionaries"}},
+		},
+	}
+
+	hints, err := p.GetCairo1Hints(casmHints)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(hints[10]) != 2 {
+		t.Fatalf("expected 2 hints at pc 10, got %d", len(hints[10]))
+	}
+	if hints[10][0].String() != "RelocateAllDictionaries" || hints[10][1].String() != "FinalizeSegment" {
+		t.Fatalf("unexpected hints at pc 10: %v", hints[10])
+	}
+	if len(hints[20]) != 1 || hints[20][0].String() != "RelocateAllDictionaries" {
+		t.Fatalf("unexpected hints at pc 20: %v", hints[20])
+	}
+}
+
+func TestGetCairo1Hints_UnknownSelectorErrors(t *testing.T) {
+	p := NewCairo1HintProcessor(false, false)
+
+	casmHints := []CasmHint{
+		{Pc: 0, Hints: []CheatcodeCall{{Selector: "NotARealCheatcode"}}},
+	}
+
+	if _, err := p.GetCairo1Hints(casmHints); err == nil {
+		t.Fatal("expected an error for an unknown cheatcode selector")
+	}
+}
@@ -15,19 +15,36 @@ type Dictionary struct {
 	// Unique id assigned at the moment of creation
 	idx uint64
 	end mem.MemoryAddress
+	// When set, `At` returns this value instead of erroring for keys that
+	// have not been explicitly written to, mirroring Cairo's default dicts
+	defaultValue *mem.MemoryValue
+	// Number of times Set has been called on this dictionary, checked
+	// against a segment_arena's n_finalized by ValidateSegmentArena
+	writes uint64
 }
 
-// Gets the memory value at certain key
+// Gets the memory value at certain key. If the dictionary was created with a
+// default value and the key has not been set, the default value is returned
+// instead of an error
 func (d *Dictionary) At(key *f.Element) (*mem.MemoryValue, error) {
 	if value, ok := d.data[*key]; ok {
 		return value, nil
 	}
+	if d.defaultValue != nil {
+		return d.defaultValue, nil
+	}
 	return nil, fmt.Errorf("no value for key %s", key)
 }
 
 // Given a key and a value, it sets the value at the given key
 func (d *Dictionary) Set(key *f.Element, value *mem.MemoryValue) {
 	d.data[*key] = value
+	d.writes++
+}
+
+// Returns the number of writes recorded for this dictionary
+func (d *Dictionary) Writes() uint64 {
+	return d.writes
 }
 
 func (d *Dictionary) SetEnd(end mem.MemoryAddress) {
@@ -45,13 +62,18 @@ type DictionaryManager struct {
 	dictionaries map[int]Dictionary
 	// useTemporarySegments is a flag that indicates if the dictionaries should be located in temporary segments, and later relocated to memory segments
 	useTemporarySegments bool
+	// segmentArenaValidation enables ValidateSegmentArena checks when
+	// dictionaries are finalized/relocated. Cairo0 programs have no
+	// segment_arena, so this only matters for Cairo1 callers
+	segmentArenaValidation bool
 }
 
-func InitializeDictionaryManager(ctx *HintRunnerContext, useTemporarySegments bool) {
+func InitializeDictionaryManager(ctx *HintRunnerContext, useTemporarySegments bool, segmentArenaValidation bool) {
 	if ctx.DictionaryManager.dictionaries == nil {
 		ctx.DictionaryManager.dictionaries = make(map[int]Dictionary)
 	}
 	ctx.DictionaryManager.useTemporarySegments = useTemporarySegments
+	ctx.DictionaryManager.segmentArenaValidation = segmentArenaValidation
 }
 
 // It creates a new segment which will hold dictionary values. It links this
@@ -71,6 +93,23 @@ func (dm *DictionaryManager) NewDictionary(vm *VM.VirtualMachine) mem.MemoryAddr
 	return newDictAddr
 }
 
+// Like NewDictionary, but the resulting dictionary returns `defaultValue` for
+// any key that has not been explicitly set, instead of erroring
+func (dm *DictionaryManager) NewDefaultDictionary(vm *VM.VirtualMachine, defaultValue *mem.MemoryValue) mem.MemoryAddress {
+	var newDictAddr mem.MemoryAddress
+	if dm.useTemporarySegments {
+		newDictAddr = vm.Memory.AllocateEmptyTemporarySegment()
+	} else {
+		newDictAddr = vm.Memory.AllocateEmptySegment()
+	}
+	dm.dictionaries[newDictAddr.SegmentIndex] = Dictionary{
+		data:         make(map[f.Element]*mem.MemoryValue),
+		idx:          uint64(len(dm.dictionaries)),
+		defaultValue: defaultValue,
+	}
+	return newDictAddr
+}
+
 // Given a memory address, it looks for the right dictionary using the segment index. If no
 // segment is associated with the given segment index, it errors
 func (dm *DictionaryManager) GetDictionary(dictAddr *mem.MemoryAddress) (Dictionary, error) {
@@ -90,6 +129,18 @@ func (dm *DictionaryManager) At(dictAddr *mem.MemoryAddress, key *f.Element) (*m
 	return nil, fmt.Errorf("no dictionary at address %s", dictAddr)
 }
 
+// Given the address of a dictionary and the address it was last written up
+// to, it records the latter as the dictionary's end. Used by hints that
+// move a dictionary's end without going through Set
+func (dm *DictionaryManager) SetEnd(dictAddr *mem.MemoryAddress, end mem.MemoryAddress) error {
+	if dict, ok := dm.dictionaries[dictAddr.SegmentIndex]; ok {
+		dict.SetEnd(end)
+		dm.dictionaries[dictAddr.SegmentIndex] = dict
+		return nil
+	}
+	return fmt.Errorf("no dictionary at address %s", dictAddr)
+}
+
 // Given a memory address,a key and a value it stores the value at the correct position.
 func (dm *DictionaryManager) Set(dictAddr *mem.MemoryAddress, key *f.Element, value *mem.MemoryValue) error {
 	if dict, ok := dm.dictionaries[dictAddr.SegmentIndex]; ok {
@@ -103,12 +154,188 @@ func (dm *DictionaryManager) Set(dictAddr *mem.MemoryAddress, key *f.Element, va
 
 // Relocates all dictionaries into a single segment if proofmode is enabled
 // In LambdaClass VM there is add_relocation_rule() used, which is used only to relocate dictionaries / in specific hint. Thus we relocate dictionaries right away.
-func (dm *DictionaryManager) RelocateAllDictionaries(vm *VM.VirtualMachine) {
+// If arenaPtr is non-nil and segmentArenaValidation is enabled, the
+// segment_arena it points to is validated first
+// so that a malformed Cairo1 program is rejected before producing a silently
+// wrong relocated layout.
+func (dm *DictionaryManager) RelocateAllDictionaries(vm *VM.VirtualMachine, arenaPtr *mem.MemoryAddress) error {
+	if arenaPtr != nil {
+		if err := dm.ValidateSegmentArena(vm, arenaPtr); err != nil {
+			return err
+		}
+	}
+
 	segmentAddr := vm.Memory.AllocateEmptySegment()
 	for key, dict := range dm.dictionaries {
 		vm.Memory.AddRelocationRule(-key, segmentAddr)
 		segmentAddr.Offset += dict.end.Offset + 1
 	}
+	return nil
+}
+
+// FinalizeSegment checks a single dictionary segment as it is finalized: it
+// errors if no dictionary is tracked at dictAddr and, when
+// segmentArenaValidation is enabled, validates just that segment's own
+// (dict_start, dict_end, n_finalized) triple against the segment_arena at
+// arenaPtr. It records no state. Unlike ValidateSegmentArena, it never
+// compares the arena's n_segments to its n_finalized, since other
+// dictionaries may still be open at the point a single one is finalized.
+// Unlike RelocateAllDictionaries, it does not relocate anything; the cairo1
+// FinalizeSegment cheatcode calls it as each dictionary is finalized one at a
+// time, while the run is still in progress
+func (dm *DictionaryManager) FinalizeSegment(vm *VM.VirtualMachine, dictAddr *mem.MemoryAddress, arenaPtr *mem.MemoryAddress) error {
+	dict, ok := dm.dictionaries[dictAddr.SegmentIndex]
+	if !ok {
+		return fmt.Errorf("no dictionary at address %s", dictAddr)
+	}
+	if !dm.segmentArenaValidation || arenaPtr == nil {
+		return nil
+	}
+
+	nSegments, infosPtr, err := readSegmentArenaHeader(vm, arenaPtr)
+	if err != nil {
+		return err
+	}
+
+	cur := *infosPtr
+	for i := uint64(0); i < nSegments; i++ {
+		dictStart, err := readSegmentAddress(vm, &cur)
+		if err != nil {
+			return err
+		}
+		if dictStart.SegmentIndex == dictAddr.SegmentIndex {
+			return validateSegmentTriple(vm, dictStart.SegmentIndex, dict, cur)
+		}
+		cur.Offset += 3
+	}
+	return fmt.Errorf("segment_arena: no entry for segment %d", dictAddr.SegmentIndex)
+}
+
+// ValidateSegmentArena walks the segment_arena infos array at arenaPtr -
+// a [n_segments, n_finalized, infos_ptr] header followed by n_segments
+// [dict_start, dict_end, n_finalized] triples - and checks that it is
+// consistent with what this manager actually tracked: every triple must
+// match a tracked dictionary, that dictionary's recorded write count must
+// equal the triple's n_finalized, and the arena's own n_segments must equal
+// its n_finalized.
+func (dm *DictionaryManager) ValidateSegmentArena(vm *VM.VirtualMachine, arenaPtr *mem.MemoryAddress) error {
+	if !dm.segmentArenaValidation || arenaPtr == nil {
+		return nil
+	}
+
+	nSegments, infosPtr, err := readSegmentArenaHeader(vm, arenaPtr)
+	if err != nil {
+		return err
+	}
+
+	nFinalizedAddr := *arenaPtr
+	nFinalizedAddr.Offset += 1
+	nFinalized, err := readUint(vm, &nFinalizedAddr)
+	if err != nil {
+		return err
+	}
+
+	if nSegments != nFinalized {
+		return fmt.Errorf("segment_arena: n_segments=%d does not match n_finalized=%d", nSegments, nFinalized)
+	}
+
+	cur := *infosPtr
+	for i := uint64(0); i < nSegments; i++ {
+		dictStart, err := readSegmentAddress(vm, &cur)
+		if err != nil {
+			return err
+		}
+
+		dict, ok := dm.dictionaries[dictStart.SegmentIndex]
+		if !ok {
+			return fmt.Errorf("segment_arena: no dictionary tracked for segment %d", dictStart.SegmentIndex)
+		}
+		if err := validateSegmentTriple(vm, dictStart.SegmentIndex, dict, cur); err != nil {
+			return err
+		}
+
+		cur.Offset += 3
+	}
+
+	return nil
+}
+
+// readSegmentArenaHeader reads the [n_segments, n_finalized, infos_ptr]
+// header at arenaPtr and returns n_segments and infos_ptr - the two fields
+// every caller needs before walking the triples that follow
+func readSegmentArenaHeader(vm *VM.VirtualMachine, arenaPtr *mem.MemoryAddress) (uint64, *mem.MemoryAddress, error) {
+	nSegmentsAddr := *arenaPtr
+	nSegments, err := readUint(vm, &nSegmentsAddr)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	infosPtrAddr := *arenaPtr
+	infosPtrAddr.Offset += 2
+	infosPtrValue, err := vm.Memory.ReadFromAddress(&infosPtrAddr)
+	if err != nil {
+		return 0, nil, err
+	}
+	infosPtr, err := infosPtrValue.MemoryAddress()
+	if err != nil {
+		return 0, nil, err
+	}
+	return nSegments, infosPtr, nil
+}
+
+// readSegmentAddress reads the dict_start field of the triple starting at
+// addr
+func readSegmentAddress(vm *VM.VirtualMachine, addr *mem.MemoryAddress) (*mem.MemoryAddress, error) {
+	value, err := vm.Memory.ReadFromAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	return value.MemoryAddress()
+}
+
+// validateSegmentTriple checks one segment_arena (dict_start, dict_end,
+// n_finalized) triple - whose dict_start is at segmentIndex and has already
+// been matched to dict - against what dict actually recorded
+func validateSegmentTriple(vm *VM.VirtualMachine, segmentIndex int, dict Dictionary, tripleStart mem.MemoryAddress) error {
+	endAddrCell := tripleStart
+	endAddrCell.Offset += 1
+	endValue, err := vm.Memory.ReadFromAddress(&endAddrCell)
+	if err != nil {
+		return err
+	}
+	dictEnd, err := endValue.MemoryAddress()
+	if err != nil {
+		return err
+	}
+
+	nFinalizedEntryAddr := tripleStart
+	nFinalizedEntryAddr.Offset += 2
+	nFinalizedEntry, err := readUint(vm, &nFinalizedEntryAddr)
+	if err != nil {
+		return err
+	}
+
+	if dict.end != *dictEnd {
+		return fmt.Errorf("segment_arena: segment %d reports dict_end=%s but the tracked dictionary ends at %s", segmentIndex, dictEnd, &dict.end)
+	}
+	if dict.writes != nFinalizedEntry {
+		return fmt.Errorf("segment_arena: segment %d reports n_finalized=%d but %d writes were recorded", segmentIndex, nFinalizedEntry, dict.writes)
+	}
+	return nil
+}
+
+// readUint reads the felt at addr and truncates it to a uint64, used for the
+// small bookkeeping counters held in a segment_arena.
+func readUint(vm *VM.VirtualMachine, addr *mem.MemoryAddress) (uint64, error) {
+	value, err := vm.Memory.ReadFromAddress(addr)
+	if err != nil {
+		return 0, err
+	}
+	felt, err := value.FieldElement()
+	if err != nil {
+		return 0, err
+	}
+	return felt.Uint64(), nil
 }
 
 // Used to keep track of squashed dictionaries
@@ -121,6 +348,31 @@ type SquashedDictionaryManager struct {
 
 	// A descending list of keys
 	Keys []f.Element
+
+	// A snapshot of len(KeyToIndices[key]) taken once, before squash_dict_inner
+	// starts popping indices off of KeyToIndices. Python's
+	// `current_access_indices = sorted(access_indices[key])[::-1]` copies the
+	// list before popping from it, so `access_indices[key]` itself is never
+	// drained; this map is what lets SquashDictInnerUsedAccessesAssert compare
+	// against that original count instead of the now-emptied KeyToIndices entry
+	AccessCount map[f.Element]uint64
+
+	// The access index last consumed out of the current key's indices list,
+	// used to compute the delta between consecutive accesses while iterating
+	// squash_dict_inner's loop
+	currentAccessIndex uint64
+}
+
+// Records the access index that was just consumed, so the next inner-loop
+// iteration can compute its delta against it
+func (sdm *SquashedDictionaryManager) SetCurrentAccessIndex(index uint64) {
+	sdm.currentAccessIndex = index
+}
+
+// Returns the access index last consumed out of the current key's indices
+// list
+func (sdm *SquashedDictionaryManager) CurrentAccessIndex() uint64 {
+	return sdm.currentAccessIndex
 }
 
 func InitializeSquashedDictionaryManager(ctx *HintRunnerContext) error {
@@ -130,9 +382,21 @@ func InitializeSquashedDictionaryManager(ctx *HintRunnerContext) error {
 	}
 	ctx.SquashedDictionaryManager.KeyToIndices = make(map[f.Element][]uint64, 100)
 	ctx.SquashedDictionaryManager.Keys = make([]f.Element, 0, 100)
+	ctx.SquashedDictionaryManager.AccessCount = make(map[f.Element]uint64, 100)
 	return nil
 }
 
+// SnapshotAccessCounts records len(KeyToIndices[key]) for every key
+// currently tracked. Must run once, after every access has been inserted but
+// before squash_dict_inner starts popping indices, so that
+// SquashDictInnerUsedAccessesAssert can later compare against each key's
+// original access count
+func (sdm *SquashedDictionaryManager) SnapshotAccessCounts() {
+	for key, indices := range sdm.KeyToIndices {
+		sdm.AccessCount[key] = uint64(len(indices))
+	}
+}
+
 // It adds another index to the list of indices associated to the given key
 // If the key is not present, it creates a new entry
 func (sdm *SquashedDictionaryManager) Insert(key *f.Element, index uint64) {
@@ -0,0 +1,110 @@
+package hinter
+
+import (
+	"testing"
+
+	f "github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+)
+
+func feltFromUint(v uint64) f.Element {
+	var e f.Element
+	e.SetUint64(v)
+	return e
+}
+
+func TestSquashedDictionaryManager_InsertAndPopIndex(t *testing.T) {
+	ctx := &HintRunnerContext{}
+	if err := InitializeSquashedDictionaryManager(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	key := feltFromUint(5)
+	ctx.SquashedDictionaryManager.Insert(&key, 1)
+	ctx.SquashedDictionaryManager.Insert(&key, 3)
+	ctx.SquashedDictionaryManager.Insert(&key, 7)
+	ctx.SquashedDictionaryManager.Keys = append(ctx.SquashedDictionaryManager.Keys, key)
+
+	// PopIndex pops from the end of the recorded (ascending) access list, so
+	// it returns the largest index first
+	index, err := ctx.SquashedDictionaryManager.PopIndex()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if index != 7 {
+		t.Fatalf("expected 7, got %d", index)
+	}
+
+	index, err = ctx.SquashedDictionaryManager.PopIndex()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if index != 3 {
+		t.Fatalf("expected 3, got %d", index)
+	}
+}
+
+func TestSquashedDictionaryManager_PopKeyReturnsSmallest(t *testing.T) {
+	ctx := &HintRunnerContext{}
+	if err := InitializeSquashedDictionaryManager(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Keys are kept in descending order, so PopKey (which pops the tail)
+	// returns the smallest key
+	smallest := feltFromUint(1)
+	largest := feltFromUint(9)
+	ctx.SquashedDictionaryManager.Keys = []f.Element{largest, smallest}
+
+	key, err := ctx.SquashedDictionaryManager.PopKey()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !key.Equal(&smallest) {
+		t.Fatalf("expected smallest key %s, got %s", &smallest, &key)
+	}
+	if len(ctx.SquashedDictionaryManager.Keys) != 1 {
+		t.Fatalf("expected 1 key left, got %d", len(ctx.SquashedDictionaryManager.Keys))
+	}
+}
+
+func TestSquashedDictionaryManager_PopKeyEmpty(t *testing.T) {
+	ctx := &HintRunnerContext{}
+	if err := InitializeSquashedDictionaryManager(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := ctx.SquashedDictionaryManager.PopKey(); err == nil {
+		t.Fatal("expected an error popping a key off an empty list")
+	}
+}
+
+func TestSquashedDictionaryManager_CurrentAccessIndexTracksAcrossCalls(t *testing.T) {
+	ctx := &HintRunnerContext{}
+	if err := InitializeSquashedDictionaryManager(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := ctx.SquashedDictionaryManager.CurrentAccessIndex(); got != 0 {
+		t.Fatalf("expected a fresh manager to start at 0, got %d", got)
+	}
+
+	ctx.SquashedDictionaryManager.SetCurrentAccessIndex(4)
+	if got := ctx.SquashedDictionaryManager.CurrentAccessIndex(); got != 4 {
+		t.Fatalf("expected 4, got %d", got)
+	}
+
+	ctx.SquashedDictionaryManager.SetCurrentAccessIndex(9)
+	if got := ctx.SquashedDictionaryManager.CurrentAccessIndex(); got != 9 {
+		t.Fatalf("expected 9, got %d", got)
+	}
+}
+
+func TestInitializeSquashedDictionaryManager_DoubleInitErrors(t *testing.T) {
+	ctx := &HintRunnerContext{}
+	if err := InitializeSquashedDictionaryManager(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := InitializeSquashedDictionaryManager(ctx); err == nil {
+		t.Fatal("expected an error initializing an already-initialized manager")
+	}
+}
@@ -0,0 +1,69 @@
+package zero
+
+import (
+	"testing"
+
+	zero "github.com/NethermindEth/cairo-vm-go/pkg/parsers/zero"
+)
+
+func TestGetHintFromCode_DictNew(t *testing.T) {
+	program := &zero.ZeroProgram{Identifiers: map[string]zero.Identifier{}}
+	rawHint := zero.Hint{Code: dictNewCode}
+
+	h, err := GetHintFromCode(program, rawHint, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if h == nil {
+		t.Fatal("expected a non-nil hinter")
+	}
+	if h.String() != "DictNew" {
+		t.Fatalf("expected DictNew hinter, got %s", h.String())
+	}
+}
+
+func TestGetHintFromCode_UnknownCode(t *testing.T) {
+	program := &zero.ZeroProgram{Identifiers: map[string]zero.Identifier{}}
+	rawHint := zero.Hint{Code: "not_a_real_hint"}
+
+	if _, err := GetHintFromCode(program, rawHint, 0); err == nil {
+		t.Fatal("expected an error for an unrecognized hint code")
+	}
+}
+
+// TestGetHintFromCode_ZeroArgHints checks that each hint code listed below,
+// whose implementation takes no resolver-bound identifiers, dispatches to a
+// hinter under the expected name, covering several of the dict_squash
+// family's entry/loop hints alongside dict_new
+func TestGetHintFromCode_ZeroArgHints(t *testing.T) {
+	cases := []struct {
+		code string
+		name string
+	}{
+		{allocSegmentCode, "AllocSegment"},
+		{dictNewCode, "DictNew"},
+		{dictSquashCopyDictCode, "DictSquashCopyDict"},
+		{squashDictInnerFirstIterationCode, "SquashDictInnerFirstIteration"},
+		{squashDictInnerSkipLoopCode, "SquashDictInnerSkipLoop"},
+		{squashDictInnerCheckAccessIndexCode, "SquashDictInnerCheckAccessIndex"},
+		{squashDictInnerContinueLoopCode, "SquashDictInnerContinueLoop"},
+		{squashDictInnerAssertLenKeysCode, "SquashDictInnerAssertLenKeys"},
+		{squashDictInnerLenAssertCode, "SquashDictInnerLenAssert"},
+	}
+
+	for _, c := range cases {
+		program := &zero.ZeroProgram{Identifiers: map[string]zero.Identifier{}}
+		rawHint := zero.Hint{Code: c.code}
+
+		h, err := GetHintFromCode(program, rawHint, 0)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.code, err)
+		}
+		if h == nil {
+			t.Fatalf("%s: expected a non-nil hinter", c.code)
+		}
+		if h.String() != c.name {
+			t.Fatalf("%s: expected %s hinter, got %s", c.code, c.name, h.String())
+		}
+	}
+}
@@ -75,6 +75,70 @@ func GetHintFromCode(program *zero.ZeroProgram, rawHint zero.Hint, hintPC uint64
 		return createIsNNHinter(resolver)
 	case isNNOutOfRangeCode:
 		return createIsNNOutOfRangeHinter(resolver)
+	case dictSquashCopyDictCode:
+		return createDictSquashCopyDictHinter(resolver)
+	case dictSquashUpdatePtrCode:
+		return createDictSquashUpdatePtrHinter(resolver)
+	case squashDictCode:
+		return createSquashDictHinter(resolver)
+	case squashDictInnerFirstIterationCode:
+		return createSquashDictInnerFirstIterationHinter(resolver)
+	case squashDictInnerSkipLoopCode:
+		return createSquashDictInnerSkipLoopHinter(resolver)
+	case squashDictInnerCheckAccessIndexCode:
+		return createSquashDictInnerCheckAccessIndexHinter(resolver)
+	case squashDictInnerContinueLoopCode:
+		return createSquashDictInnerContinueLoopHinter(resolver)
+	case squashDictInnerAssertLenKeysCode:
+		return createSquashDictInnerAssertLenKeysHinter(resolver)
+	case squashDictInnerLenAssertCode:
+		return createSquashDictInnerLenAssertHinter(resolver)
+	case squashDictInnerUsedAccessesAssertCode:
+		return createSquashDictInnerUsedAccessesAssertHinter(resolver)
+	case squashDictInnerNextKeyCode:
+		return createSquashDictInnerNextKeyHinter(resolver)
+	case unsignedDivRemCode:
+		return createUnsignedDivRemHinter(resolver)
+	case signedDivRemCode:
+		return createSignedDivRemHinter(resolver)
+	case splitFeltCode:
+		return createSplitFeltHinter(resolver)
+	case sqrtCode:
+		return createSqrtHinter(resolver)
+	case assert250BitCode:
+		return createAssert250BitHinter(resolver)
+	case isPositiveCode:
+		return createIsPositiveHinter(resolver)
+	case splitIntCode:
+		return createSplitIntHinter(resolver)
+	case powCode:
+		return createPowHinter(resolver)
+	case uint256AddCode:
+		return createUint256AddHinter(resolver)
+	case uint256SubCode:
+		return createUint256SubHinter(resolver)
+	case uint256MulDivModCode:
+		return createUint256MulDivModHinter(resolver)
+	case uint256SqrtCode:
+		return createUint256SqrtHinter(resolver)
+	case uint256UnsignedDivRemCode:
+		return createUint256UnsignedDivRemHinter(resolver)
+	case uint256SignedNNCode:
+		return createUint256SignedNNHinter(resolver)
+	case dictNewCode:
+		return createDictNewHinter(resolver)
+	case defaultDictNewCode:
+		return createDefaultDictNewHinter(resolver)
+	case dictReadCode:
+		return createDictReadHinter(resolver)
+	case dictWriteCode:
+		return createDictWriteHinter(resolver)
+	case dictUpdateCode:
+		return createDictUpdateHinter(resolver)
+	case findElementCode:
+		return createFindElementHinter(resolver)
+	case searchSortedLowerCode:
+		return createSearchSortedLowerHinter(resolver)
 	default:
 		return nil, fmt.Errorf("Not identified hint")
 	}
@@ -303,7 +367,7 @@ func createIsNNOutOfRangeHinter(resolver hintReferenceResolver) (hinter.Hinter,
 			lhs.Sub(&utils.FeltZero, aFelt) //> -ids.a
 			lhs.Sub(&lhs, &utils.FeltOne)
 			var v memory.MemoryValue
-			if utils.FeltLt(aFelt, &utils.FeltMax128) {
+			if utils.FeltLt(&lhs, &utils.FeltMax128) {
 				v = memory.MemoryValueFromFieldElement(&utils.FeltZero)
 			} else {
 				v = memory.MemoryValueFromFieldElement(&utils.FeltOne)
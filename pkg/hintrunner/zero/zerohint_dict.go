@@ -0,0 +1,215 @@
+package zero
+
+import (
+	"fmt"
+
+	"github.com/NethermindEth/cairo-vm-go/pkg/hintrunner/hinter"
+	VM "github.com/NethermindEth/cairo-vm-go/pkg/vm"
+	"github.com/NethermindEth/cairo-vm-go/pkg/vm/memory"
+)
+
+const (
+	dictNewCode        = "dict_new"
+	defaultDictNewCode = "default_dict_new"
+	dictReadCode       = "dict_read"
+	dictWriteCode      = "dict_write"
+	dictUpdateCode     = "dict_update"
+)
+
+func createDictNewHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	h := &GenericZeroHinter{
+		Name: "DictNew",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> if '__dict_manager' not in globals():
+			//>     from starkware.cairo.common.dict import DictManager
+			//>     __dict_manager = DictManager()
+			//> memory[ap] = __dict_manager.new_dict(segments, initial_dict)
+			dictAddr := ctx.DictionaryManager.NewDictionary(vm)
+			apAddr := vm.Context.AddressAp()
+			v := memory.MemoryValueFromMemoryAddress(&dictAddr)
+			return vm.Memory.WriteToAddress(&apAddr, &v)
+		},
+	}
+	return h, nil
+}
+
+func createDefaultDictNewHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	defaultValue, err := resolver.GetResOperander("default_value")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "DefaultDictNew",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> if '__dict_manager' not in globals():
+			//>     from starkware.cairo.common.dict import DictManager
+			//>     __dict_manager = DictManager()
+			//> memory[ap] = __dict_manager.new_default_dict(segments, ids.default_value)
+			defaultFelt, err := resolveFelt(vm, defaultValue)
+			if err != nil {
+				return err
+			}
+			defaultMemValue := memory.MemoryValueFromFieldElement(defaultFelt)
+
+			dictAddr := ctx.DictionaryManager.NewDefaultDictionary(vm, &defaultMemValue)
+			apAddr := vm.Context.AddressAp()
+			v := memory.MemoryValueFromMemoryAddress(&dictAddr)
+			return vm.Memory.WriteToAddress(&apAddr, &v)
+		},
+	}
+	return h, nil
+}
+
+func createDictReadHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	dictPtr, err := resolver.GetResOperander("dict_ptr")
+	if err != nil {
+		return nil, err
+	}
+	key, err := resolver.GetResOperander("key")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "DictRead",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> dict_tracker = __dict_manager.get_tracker(ids.dict_ptr)
+			//> dict_tracker.current_ptr += ids.DictAccess.SIZE
+			//> ids.value = dict_tracker.data[ids.key]
+			dictPtrAddr, err := resolveAddr(vm, dictPtr)
+			if err != nil {
+				return err
+			}
+			keyFelt, err := resolveFelt(vm, key)
+			if err != nil {
+				return err
+			}
+
+			value, err := ctx.DictionaryManager.At(dictPtrAddr, keyFelt)
+			if err != nil {
+				return err
+			}
+			if err := ctx.DictionaryManager.SetEnd(dictPtrAddr, *dictPtrAddr); err != nil {
+				return err
+			}
+
+			apAddr := vm.Context.AddressAp()
+			return vm.Memory.WriteToAddress(&apAddr, value)
+		},
+	}
+	return h, nil
+}
+
+func createDictWriteHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	dictPtr, err := resolver.GetResOperander("dict_ptr")
+	if err != nil {
+		return nil, err
+	}
+	key, err := resolver.GetResOperander("key")
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := resolver.GetResOperander("new_value")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "DictWrite",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> dict_tracker = __dict_manager.get_tracker(ids.dict_ptr)
+			//> dict_tracker.current_ptr += ids.DictAccess.SIZE
+			//> dict_tracker.data[ids.key] = ids.new_value
+			dictPtrAddr, err := resolveAddr(vm, dictPtr)
+			if err != nil {
+				return err
+			}
+			keyFelt, err := resolveFelt(vm, key)
+			if err != nil {
+				return err
+			}
+			newValueMem, err := newValue.Resolve(vm)
+			if err != nil {
+				return err
+			}
+
+			return ctx.DictionaryManager.Set(dictPtrAddr, keyFelt, &newValueMem)
+		},
+	}
+	return h, nil
+}
+
+func createDictUpdateHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	dictPtr, err := resolver.GetResOperander("dict_ptr")
+	if err != nil {
+		return nil, err
+	}
+	key, err := resolver.GetResOperander("key")
+	if err != nil {
+		return nil, err
+	}
+	prevValue, err := resolver.GetResOperander("prev_value")
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := resolver.GetResOperander("new_value")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "DictUpdate",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> dict_tracker = __dict_manager.get_tracker(ids.dict_ptr)
+			//> current_value = dict_tracker.data[ids.key]
+			//> assert current_value == ids.prev_value, \
+			//>     f'Wrong previous value in dict. Got {ids.prev_value}, expected {current_value}.'
+			//> dict_tracker.data[ids.key] = ids.new_value
+			//> dict_tracker.current_ptr += ids.DictAccess.SIZE
+			dictPtrAddr, err := resolveAddr(vm, dictPtr)
+			if err != nil {
+				return err
+			}
+			keyFelt, err := resolveFelt(vm, key)
+			if err != nil {
+				return err
+			}
+			prevValueMem, err := prevValue.Resolve(vm)
+			if err != nil {
+				return err
+			}
+			newValueMem, err := newValue.Resolve(vm)
+			if err != nil {
+				return err
+			}
+
+			currentValue, err := ctx.DictionaryManager.At(dictPtrAddr, keyFelt)
+			if err != nil {
+				return err
+			}
+			prevFelt, err := prevValueMem.FieldElement()
+			if err != nil {
+				return err
+			}
+			currentFelt, err := currentValue.FieldElement()
+			if err != nil {
+				return err
+			}
+			if !currentFelt.Equal(prevFelt) {
+				return fmt.Errorf("dict_update: wrong previous value in dict, got %s, expected %s", prevFelt, currentFelt)
+			}
+
+			return ctx.DictionaryManager.Set(dictPtrAddr, keyFelt, &newValueMem)
+		},
+	}
+	return h, nil
+}
+
+func resolveAddr(vm *VM.VirtualMachine, op hinter.ResOperander) (*memory.MemoryAddress, error) {
+	value, err := op.Resolve(vm)
+	if err != nil {
+		return nil, err
+	}
+	return value.MemoryAddress()
+}
@@ -0,0 +1,389 @@
+package zero
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/NethermindEth/cairo-vm-go/pkg/hintrunner/hinter"
+	"github.com/NethermindEth/cairo-vm-go/pkg/utils"
+	VM "github.com/NethermindEth/cairo-vm-go/pkg/vm"
+	"github.com/NethermindEth/cairo-vm-go/pkg/vm/memory"
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+)
+
+const (
+	unsignedDivRemCode = "unsigned_div_rem"
+	signedDivRemCode   = "signed_div_rem"
+	splitFeltCode      = "split_felt"
+	sqrtCode           = "sqrt"
+	assert250BitCode   = "assert_250_bit"
+	isPositiveCode     = "is_positive"
+	splitIntCode       = "split_int"
+	powCode            = "pow"
+)
+
+// shift128 is 2**128, the split point between the low and high limbs used
+// throughout the common library's uint256/felt-splitting hints
+var shift128 = new(big.Int).Lsh(big.NewInt(1), 128)
+
+func createUnsignedDivRemHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	value, err := resolver.GetResOperander("value")
+	if err != nil {
+		return nil, err
+	}
+	div, err := resolver.GetResOperander("div")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "UnsignedDivRem",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> ids.q, ids.r = divmod(ids.value, ids.div)
+			valueFelt, err := resolveFelt(vm, value)
+			if err != nil {
+				return err
+			}
+			divFelt, err := resolveFelt(vm, div)
+			if err != nil {
+				return err
+			}
+			if divFelt.IsZero() {
+				return fmt.Errorf("unsigned_div_rem: division by zero")
+			}
+
+			var valueBig, divBig big.Int
+			valueFelt.BigInt(&valueBig)
+			divFelt.BigInt(&divBig)
+
+			q, r := new(big.Int), new(big.Int)
+			q.DivMod(&valueBig, &divBig, r)
+
+			return writeFeltsAtAp(vm, bigIntToFelt(q), bigIntToFelt(r))
+		},
+	}
+	return h, nil
+}
+
+func createSignedDivRemHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	value, err := resolver.GetResOperander("value")
+	if err != nil {
+		return nil, err
+	}
+	div, err := resolver.GetResOperander("div")
+	if err != nil {
+		return nil, err
+	}
+	bound, err := resolver.GetResOperander("bound")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "SignedDivRem",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> from starkware.cairo.common.math_utils import as_int, assert_integer
+			//> assert_integer(ids.div)
+			//> assert 0 < ids.div <= PRIME // range_check_builtin.bound
+			//> assert_integer(ids.bound)
+			//> assert ids.bound <= range_check_builtin.bound // 2
+			//> int_value = as_int(ids.value, PRIME)
+			//> q, r = divmod(int_value, ids.div)
+			//> assert -ids.bound <= q < ids.bound
+			//> ids.q = q % PRIME
+			//> ids.r = r % PRIME
+			valueFelt, err := resolveFelt(vm, value)
+			if err != nil {
+				return err
+			}
+			divFelt, err := resolveFelt(vm, div)
+			if err != nil {
+				return err
+			}
+			boundFelt, err := resolveFelt(vm, bound)
+			if err != nil {
+				return err
+			}
+			if divFelt.IsZero() {
+				return fmt.Errorf("signed_div_rem: division by zero")
+			}
+
+			signedValue := asInt(valueFelt)
+			var divBig big.Int
+			divFelt.BigInt(&divBig)
+
+			q, r := new(big.Int), new(big.Int)
+			q.DivMod(signedValue, &divBig, r)
+			if r.Sign() < 0 {
+				q.Sub(q, big.NewInt(1))
+				r.Add(r, &divBig)
+			}
+
+			var boundBig big.Int
+			boundFelt.BigInt(&boundBig)
+			negBound := new(big.Int).Neg(&boundBig)
+			if q.Cmp(negBound) < 0 || q.Cmp(&boundBig) >= 0 {
+				return fmt.Errorf("signed_div_rem: quotient %s out of range [-%s, %s)", q, &boundBig, &boundBig)
+			}
+
+			return writeFeltsAtAp(vm, bigIntToFelt(q), bigIntToFelt(r))
+		},
+	}
+	return h, nil
+}
+
+func createSplitFeltHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	value, err := resolver.GetResOperander("value")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "SplitFelt",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> assert ids.MAX_HIGH * (2 ** 128) + ids.MAX_LOW == MAX_FELT
+			//> assert PRIME < 2 ** 256
+			//> ids.low = ids.value & ((1 << 128) - 1)
+			//> ids.high = ids.value >> 128
+			valueFelt, err := resolveFelt(vm, value)
+			if err != nil {
+				return err
+			}
+			var valueBig big.Int
+			valueFelt.BigInt(&valueBig)
+
+			high := new(big.Int).Rsh(&valueBig, 128)
+			low := new(big.Int).And(&valueBig, new(big.Int).Sub(shift128, big.NewInt(1)))
+
+			return writeFeltsAtAp(vm, bigIntToFelt(low), bigIntToFelt(high))
+		},
+	}
+	return h, nil
+}
+
+func createSqrtHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	value, err := resolver.GetResOperander("value")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "Sqrt",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> from starkware.python.math_utils import isqrt
+			//> value = ids.value % PRIME
+			//> ids.root = isqrt(value)
+			valueFelt, err := resolveFelt(vm, value)
+			if err != nil {
+				return err
+			}
+			var valueBig big.Int
+			valueFelt.BigInt(&valueBig)
+
+			root := new(big.Int).Sqrt(&valueBig)
+
+			apAddr := vm.Context.AddressAp()
+			v := memory.MemoryValueFromFieldElement(bigIntToFelt(root))
+			return vm.Memory.WriteToAddress(&apAddr, &v)
+		},
+	}
+	return h, nil
+}
+
+func createAssert250BitHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	value, err := resolver.GetResOperander("value")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "Assert250Bit",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> from starkware.cairo.common.math_utils import as_int
+			//> ids.high, ids.low = divmod(ids.value, ids.SHIFT)
+			valueFelt, err := resolveFelt(vm, value)
+			if err != nil {
+				return err
+			}
+			var valueBig big.Int
+			valueFelt.BigInt(&valueBig)
+
+			upperBound := new(big.Int).Lsh(big.NewInt(1), 250)
+			if valueBig.Cmp(upperBound) >= 0 {
+				return fmt.Errorf("assert_250_bit: value %s is out of range", &valueBig)
+			}
+
+			high := new(big.Int).Rsh(&valueBig, 128)
+			low := new(big.Int).And(&valueBig, new(big.Int).Sub(shift128, big.NewInt(1)))
+
+			return writeFeltsAtAp(vm, bigIntToFelt(low), bigIntToFelt(high))
+		},
+	}
+	return h, nil
+}
+
+func createIsPositiveHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	value, err := resolver.GetResOperander("value")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "IsPositive",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> from starkware.cairo.common.math_utils import is_positive
+			//> ids.is_positive = 1 if is_positive(
+			//>     value=ids.value, prime=PRIME, rc_bound=range_check_builtin.bound) else 0
+			valueFelt, err := resolveFelt(vm, value)
+			if err != nil {
+				return err
+			}
+			signedValue := asInt(valueFelt)
+
+			apAddr := vm.Context.AddressAp()
+			var v memory.MemoryValue
+			if signedValue.Sign() > 0 {
+				v = memory.MemoryValueFromFieldElement(&utils.FeltOne)
+			} else {
+				v = memory.MemoryValueFromFieldElement(&utils.FeltZero)
+			}
+			return vm.Memory.WriteToAddress(&apAddr, &v)
+		},
+	}
+	return h, nil
+}
+
+func createSplitIntHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	value, err := resolver.GetResOperander("value")
+	if err != nil {
+		return nil, err
+	}
+	base, err := resolver.GetResOperander("base")
+	if err != nil {
+		return nil, err
+	}
+	bound, err := resolver.GetResOperander("bound")
+	if err != nil {
+		return nil, err
+	}
+	output, err := resolver.GetResOperander("output")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "SplitInt",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> memory[ids.output] = res = (int(ids.value) % PRIME) % ids.base
+			//> assert res < ids.bound, f'split_int(): Limb {res} is out of range.'
+			valueFelt, err := resolveFelt(vm, value)
+			if err != nil {
+				return err
+			}
+			baseFelt, err := resolveFelt(vm, base)
+			if err != nil {
+				return err
+			}
+			boundFelt, err := resolveFelt(vm, bound)
+			if err != nil {
+				return err
+			}
+
+			var valueBig, baseBig, boundBig big.Int
+			valueFelt.BigInt(&valueBig)
+			baseFelt.BigInt(&baseBig)
+			boundFelt.BigInt(&boundBig)
+
+			res := new(big.Int).Mod(&valueBig, &baseBig)
+			if res.Cmp(&boundBig) >= 0 {
+				return fmt.Errorf("split_int(): limb %s is out of range", res)
+			}
+
+			outputValue, err := output.Resolve(vm)
+			if err != nil {
+				return err
+			}
+			outputAddr, err := outputValue.MemoryAddress()
+			if err != nil {
+				return err
+			}
+
+			v := memory.MemoryValueFromFieldElement(bigIntToFelt(res))
+			return vm.Memory.WriteToAddress(outputAddr, &v)
+		},
+	}
+	return h, nil
+}
+
+func createPowHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	exp, err := resolver.GetResOperander("exp")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "Pow",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> ids.locs.bit = (ids.prev_locs.exp % PRIME) & 1
+			expFelt, err := resolveFelt(vm, exp)
+			if err != nil {
+				return err
+			}
+			var expBig big.Int
+			expFelt.BigInt(&expBig)
+
+			apAddr := vm.Context.AddressAp()
+			var v memory.MemoryValue
+			if expBig.Bit(0) == 1 {
+				v = memory.MemoryValueFromFieldElement(&utils.FeltOne)
+			} else {
+				v = memory.MemoryValueFromFieldElement(&utils.FeltZero)
+			}
+			return vm.Memory.WriteToAddress(&apAddr, &v)
+		},
+	}
+	return h, nil
+}
+
+// asInt interprets a field element as a signed integer in the symmetric
+// range (-PRIME/2, PRIME/2], the convention used throughout the common
+// library's math hints (math_utils.as_int)
+func asInt(value *fp.Element) *big.Int {
+	var valueBig big.Int
+	value.BigInt(&valueBig)
+	primeBig := fp.Modulus()
+
+	half := new(big.Int).Rsh(primeBig, 1)
+	if valueBig.Cmp(half) > 0 {
+		valueBig.Sub(&valueBig, primeBig)
+	}
+	return &valueBig
+}
+
+func bigIntToFelt(v *big.Int) *fp.Element {
+	var felt fp.Element
+	felt.SetBigInt(v)
+	return &felt
+}
+
+func resolveFelt(vm *VM.VirtualMachine, op hinter.ResOperander) (*fp.Element, error) {
+	value, err := op.Resolve(vm)
+	if err != nil {
+		return nil, err
+	}
+	return value.FieldElement()
+}
+
+// writeFeltsAtAp writes each felt to consecutive cells starting at ap, the
+// convention this hinter package uses for multi-output hints
+func writeFeltsAtAp(vm *VM.VirtualMachine, felts ...*fp.Element) error {
+	addr := vm.Context.AddressAp()
+	for _, felt := range felts {
+		v := memory.MemoryValueFromFieldElement(felt)
+		if err := vm.Memory.WriteToAddress(&addr, &v); err != nil {
+			return err
+		}
+		addr.Offset += 1
+	}
+	return nil
+}
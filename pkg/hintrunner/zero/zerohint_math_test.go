@@ -0,0 +1,59 @@
+package zero
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+)
+
+func TestAsInt_SmallPositive(t *testing.T) {
+	var value fp.Element
+	value.SetUint64(42)
+
+	got := asInt(&value)
+	if got.Cmp(big.NewInt(42)) != 0 {
+		t.Fatalf("expected 42, got %s", got)
+	}
+}
+
+func TestAsInt_WrapsNearPrimeToNegative(t *testing.T) {
+	var value fp.Element
+	value.SetUint64(1)
+	value.Neg(&value) // value = PRIME - 1, i.e. -1 mod PRIME
+
+	got := asInt(&value)
+	if got.Cmp(big.NewInt(-1)) != 0 {
+		t.Fatalf("expected -1, got %s", got)
+	}
+}
+
+func TestBigIntToFelt_RoundTrip(t *testing.T) {
+	v := big.NewInt(123456789)
+	felt := bigIntToFelt(v)
+
+	var back big.Int
+	felt.BigInt(&back)
+	if back.Cmp(v) != 0 {
+		t.Fatalf("expected %s, got %s", v, &back)
+	}
+}
+
+func TestBigIntToFelt_NegativeWrapsToFieldElement(t *testing.T) {
+	v := big.NewInt(-1)
+	felt := bigIntToFelt(v)
+
+	one := bigIntToFelt(big.NewInt(1))
+	var sum fp.Element
+	sum.Add(felt, one)
+	if !sum.IsZero() {
+		t.Fatalf("expected bigIntToFelt(-1) + 1 == 0, got %s", &sum)
+	}
+}
+
+func TestShift128(t *testing.T) {
+	want := new(big.Int).Exp(big.NewInt(2), big.NewInt(128), nil)
+	if shift128.Cmp(want) != 0 {
+		t.Fatalf("expected 2**128 = %s, got %s", want, shift128)
+	}
+}
@@ -0,0 +1,170 @@
+package zero
+
+import (
+	"fmt"
+
+	"github.com/NethermindEth/cairo-vm-go/pkg/hintrunner/hinter"
+	"github.com/NethermindEth/cairo-vm-go/pkg/utils"
+	VM "github.com/NethermindEth/cairo-vm-go/pkg/vm"
+	"github.com/NethermindEth/cairo-vm-go/pkg/vm/memory"
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+)
+
+const (
+	findElementCode       = "find_element"
+	searchSortedLowerCode = "search_sorted_lower"
+)
+
+func createFindElementHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	arrayPtr, err := resolver.GetResOperander("array_ptr")
+	if err != nil {
+		return nil, err
+	}
+	elmSize, err := resolver.GetResOperander("elm_size")
+	if err != nil {
+		return nil, err
+	}
+	nElms, err := resolver.GetResOperander("n_elms")
+	if err != nil {
+		return nil, err
+	}
+	key, err := resolver.GetResOperander("key")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "FindElement",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> array_ptr = ids.array_ptr
+			//> elm_size = ids.elm_size
+			//> n_elms = ids.n_elms
+			//> for i in range(n_elms):
+			//>     if memory[array_ptr + elm_size * i] == ids.key:
+			//>         ids.index = i
+			//>         break
+			//> else:
+			//>     raise ValueError(f'Key {ids.key} was not found.')
+			index, err := searchArray(vm, arrayPtr, elmSize, nElms, key, equalMatch)
+			if err != nil {
+				return err
+			}
+			if index == nil {
+				keyFelt, resolveErr := resolveFelt(vm, key)
+				if resolveErr != nil {
+					return resolveErr
+				}
+				return fmt.Errorf("find_element: key %s was not found", keyFelt)
+			}
+
+			apAddr := vm.Context.AddressAp()
+			v := memory.MemoryValueFromUint(*index)
+			return vm.Memory.WriteToAddress(&apAddr, &v)
+		},
+	}
+	return h, nil
+}
+
+func createSearchSortedLowerHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	arrayPtr, err := resolver.GetResOperander("array_ptr")
+	if err != nil {
+		return nil, err
+	}
+	elmSize, err := resolver.GetResOperander("elm_size")
+	if err != nil {
+		return nil, err
+	}
+	nElms, err := resolver.GetResOperander("n_elms")
+	if err != nil {
+		return nil, err
+	}
+	key, err := resolver.GetResOperander("key")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "SearchSortedLower",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> array_ptr = ids.array_ptr
+			//> elm_size = ids.elm_size
+			//> n_elms = ids.n_elms
+			//> for i in range(n_elms):
+			//>     if memory[array_ptr + elm_size * i] >= ids.key:
+			//>         ids.index = i
+			//>         break
+			//> else:
+			//>     ids.index = n_elms
+			index, err := searchArray(vm, arrayPtr, elmSize, nElms, key, greaterOrEqualMatch)
+			if err != nil {
+				return err
+			}
+
+			nElmsFelt, err := resolveFelt(vm, nElms)
+			if err != nil {
+				return err
+			}
+			resultIndex := nElmsFelt.Uint64()
+			if index != nil {
+				resultIndex = *index
+			}
+
+			apAddr := vm.Context.AddressAp()
+			v := memory.MemoryValueFromUint(resultIndex)
+			return vm.Memory.WriteToAddress(&apAddr, &v)
+		},
+	}
+	return h, nil
+}
+
+type feltMatcher func(elm, key *fp.Element) bool
+
+func equalMatch(elm, key *fp.Element) bool {
+	return elm.Equal(key)
+}
+
+func greaterOrEqualMatch(elm, key *fp.Element) bool {
+	return !utils.FeltLt(elm, key)
+}
+
+// searchArray walks `n_elms` elements of size `elm_size` starting at
+// `array_ptr`, returning the index of the first one that `match`es `key`, or
+// nil if none do
+func searchArray(vm *VM.VirtualMachine, arrayPtr, elmSize, nElms, key hinter.ResOperander, match feltMatcher) (*uint64, error) {
+	arrayAddr, err := resolveAddr(vm, arrayPtr)
+	if err != nil {
+		return nil, err
+	}
+	elmSizeFelt, err := resolveFelt(vm, elmSize)
+	if err != nil {
+		return nil, err
+	}
+	nElmsFelt, err := resolveFelt(vm, nElms)
+	if err != nil {
+		return nil, err
+	}
+	keyFelt, err := resolveFelt(vm, key)
+	if err != nil {
+		return nil, err
+	}
+
+	size := elmSizeFelt.Uint64()
+	n := nElmsFelt.Uint64()
+
+	cur := *arrayAddr
+	for i := uint64(0); i < n; i++ {
+		elmValue, err := vm.Memory.ReadFromAddress(&cur)
+		if err != nil {
+			return nil, err
+		}
+		elmFelt, err := elmValue.FieldElement()
+		if err != nil {
+			return nil, err
+		}
+		if match(elmFelt, keyFelt) {
+			return &i, nil
+		}
+		cur.Offset += size
+	}
+	return nil, nil
+}
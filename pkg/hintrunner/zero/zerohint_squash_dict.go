@@ -0,0 +1,373 @@
+package zero
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/NethermindEth/cairo-vm-go/pkg/hintrunner/hinter"
+	"github.com/NethermindEth/cairo-vm-go/pkg/utils"
+	VM "github.com/NethermindEth/cairo-vm-go/pkg/vm"
+	"github.com/NethermindEth/cairo-vm-go/pkg/vm/memory"
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+)
+
+// Hint codes for the `dict_squash` family, i.e. the hints attached to
+// starkware.cairo.common.dict and starkware.cairo.common.squash_dict
+const (
+	dictSquashCopyDictCode                = "dict_squash_copy_dict"
+	dictSquashUpdatePtrCode               = "dict_squash_update_ptr"
+	squashDictCode                        = "squash_dict"
+	squashDictInnerFirstIterationCode     = "squash_dict_inner_first_iteration"
+	squashDictInnerSkipLoopCode           = "squash_dict_inner_skip_loop"
+	squashDictInnerCheckAccessIndexCode   = "squash_dict_inner_check_access_index"
+	squashDictInnerContinueLoopCode       = "squash_dict_inner_continue_loop"
+	squashDictInnerAssertLenKeysCode      = "squash_dict_inner_assert_len_keys"
+	squashDictInnerLenAssertCode          = "squash_dict_inner_len_assert"
+	squashDictInnerUsedAccessesAssertCode = "squash_dict_inner_used_accesses_assert"
+	squashDictInnerNextKeyCode            = "squash_dict_inner_next_key"
+)
+
+// maxSquashDictAccesses bounds the number of accesses `squash_dict` is
+// willing to process in a single call, mirroring the Python VM's
+// `__squash_dict_max_size` safety check
+const maxSquashDictAccesses = 1 << 20
+
+// dictAccessSize is the number of memory cells in a DictAccess struct:
+// [key, prev_value, new_value]
+const dictAccessSize = 3
+
+// createDictSquashCopyDictHinter allocates the segment that will hold the
+// squashed [key, prev, new] triples. When `UseTemporarySegments` is set on
+// the dictionary manager, this segment is a temporary one that
+// `RelocateAllDictionaries` flattens once the run is finalized
+func createDictSquashCopyDictHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	h := &GenericZeroHinter{
+		Name: "DictSquashCopyDict",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> vm_enter_scope({'n_accesses': n_accesses, 'access_indices': access_indices})
+			newDictAddr := ctx.DictionaryManager.NewDictionary(vm)
+			apAddr := vm.Context.AddressAp()
+			v := memory.MemoryValueFromMemoryAddress(&newDictAddr)
+			return vm.Memory.WriteToAddress(&apAddr, &v)
+		},
+	}
+	return h, nil
+}
+
+// createDictSquashUpdatePtrHinter records the end of the squashed dictionary
+// segment so that later reads against `squashed_dict_start` see the fully
+// squashed contents
+func createDictSquashUpdatePtrHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	squashedDictStart, err := resolver.GetResOperander("squashed_dict_start")
+	if err != nil {
+		return nil, err
+	}
+	squashedDictEnd, err := resolver.GetResOperander("squashed_dict_end")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "DictSquashUpdatePtr",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> # Update the DictTracker's current_ptr to point to the end of the squashed dict.
+			//> __dict_manager.get_tracker(ids.squashed_dict_start).current_ptr = \
+			//>     ids.squashed_dict_end.address_
+			startValue, err := squashedDictStart.Resolve(vm)
+			if err != nil {
+				return err
+			}
+			startAddr, err := startValue.MemoryAddress()
+			if err != nil {
+				return err
+			}
+			endValue, err := squashedDictEnd.Resolve(vm)
+			if err != nil {
+				return err
+			}
+			endAddr, err := endValue.MemoryAddress()
+			if err != nil {
+				return err
+			}
+			return ctx.DictionaryManager.SetEnd(startAddr, *endAddr)
+		},
+	}
+	return h, nil
+}
+
+// createSquashDictHinter builds the entry hint of `squash_dict`: it reads the
+// [key, prev, new] triples recorded between `dict_accesses` and
+// `dict_accesses_end`, groups every access index by key into
+// `SquashedDictionaryManager.KeyToIndices` and produces the descending list
+// of unique keys to iterate over
+func createSquashDictHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	dictAccesses, err := resolver.GetResOperander("dict_accesses")
+	if err != nil {
+		return nil, err
+	}
+	dictAccessesEnd, err := resolver.GetResOperander("dict_accesses_end")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "SquashDict",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> dict_access_size = ids.DictAccess.SIZE
+			//> address = ids.dict_accesses.address_
+			//> assert ids.n_accesses <= 2 ** 20
+			//> access_indices = {}
+			//> for i in range(ids.n_accesses):
+			//>     key = memory[address + dict_access_size * i]
+			//>     access_indices.setdefault(key, []).append(i)
+			//> keys = sorted(access_indices.keys(), reverse=True)
+			//> ids.big_keys = 1 if keys[0] >= range_check_builtin.bound else 0
+			//> ids.first_key = key = keys.pop()
+			startValue, err := dictAccesses.Resolve(vm)
+			if err != nil {
+				return err
+			}
+			startAddr, err := startValue.MemoryAddress()
+			if err != nil {
+				return err
+			}
+			endValue, err := dictAccessesEnd.Resolve(vm)
+			if err != nil {
+				return err
+			}
+			endAddr, err := endValue.MemoryAddress()
+			if err != nil {
+				return err
+			}
+
+			if err := InitializeSquashedDictionaryManager(ctx); err != nil {
+				return err
+			}
+
+			nAccesses := (endAddr.Offset - startAddr.Offset) / dictAccessSize
+			if nAccesses > maxSquashDictAccesses {
+				return fmt.Errorf("squash_dict can only be used with n_accesses<=%d. Got: n_accesses=%d", maxSquashDictAccesses, nAccesses)
+			}
+
+			cur := *startAddr
+			for i := uint64(0); i < nAccesses; i++ {
+				keyValue, err := vm.Memory.ReadFromAddress(&cur)
+				if err != nil {
+					return err
+				}
+				key, err := keyValue.FieldElement()
+				if err != nil {
+					return err
+				}
+				ctx.SquashedDictionaryManager.Insert(key, i)
+				cur.Offset += dictAccessSize
+			}
+
+			// Keep each key's indices in descending order so that PopIndex
+			// yields them smallest first
+			for _, indices := range ctx.SquashedDictionaryManager.KeyToIndices {
+				for i, j := 0, len(indices)-1; i < j; i, j = i+1, j-1 {
+					indices[i], indices[j] = indices[j], indices[i]
+				}
+			}
+
+			ctx.SquashedDictionaryManager.SnapshotAccessCounts()
+
+			for key := range ctx.SquashedDictionaryManager.KeyToIndices {
+				ctx.SquashedDictionaryManager.Keys = append(ctx.SquashedDictionaryManager.Keys, key)
+			}
+			keys := ctx.SquashedDictionaryManager.Keys
+			sort.Slice(keys, func(i, j int) bool {
+				return utils.FeltLt(&keys[j], &keys[i])
+			})
+
+			largestKey := keys[0]
+			var bigKeys fp.Element
+			if utils.FeltLt(&largestKey, &utils.FeltMax128) {
+				bigKeys = utils.FeltZero
+			} else {
+				bigKeys = utils.FeltOne
+			}
+
+			firstKey, err := ctx.SquashedDictionaryManager.PopKey()
+			if err != nil {
+				return err
+			}
+
+			return writeFeltsAtAp(vm, &bigKeys, &firstKey)
+		},
+	}
+	return h, nil
+}
+
+func createSquashDictInnerFirstIterationHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	h := &GenericZeroHinter{
+		Name: "SquashDictInnerFirstIteration",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> current_access_indices = sorted(access_indices[key])[::-1]
+			//> current_access_index = current_access_indices.pop()
+			//> memory[ap] = current_access_index
+			index, err := ctx.SquashedDictionaryManager.PopIndex()
+			if err != nil {
+				return err
+			}
+			ctx.SquashedDictionaryManager.SetCurrentAccessIndex(index)
+
+			apAddr := vm.Context.AddressAp()
+			v := memory.MemoryValueFromUint(index)
+			return vm.Memory.WriteToAddress(&apAddr, &v)
+		},
+	}
+	return h, nil
+}
+
+func createSquashDictInnerSkipLoopHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	h := &GenericZeroHinter{
+		Name: "SquashDictInnerSkipLoop",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> ids.should_skip_loop = 0 if current_access_indices else 1
+			indices, err := ctx.SquashedDictionaryManager.LastIndices()
+			if err != nil {
+				return err
+			}
+			apAddr := vm.Context.AddressAp()
+			var v memory.MemoryValue
+			if len(indices) == 0 {
+				v = memory.MemoryValueFromFieldElement(&utils.FeltOne)
+			} else {
+				v = memory.MemoryValueFromFieldElement(&utils.FeltZero)
+			}
+			return vm.Memory.WriteToAddress(&apAddr, &v)
+		},
+	}
+	return h, nil
+}
+
+func createSquashDictInnerCheckAccessIndexHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	h := &GenericZeroHinter{
+		Name: "SquashDictInnerCheckAccessIndex",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> new_access_index = current_access_indices.pop()
+			//> ids.loop_temps.index_delta_minus1 = new_access_index - current_access_index - 1
+			//> current_access_index = new_access_index
+			newIndex, err := ctx.SquashedDictionaryManager.PopIndex()
+			if err != nil {
+				return err
+			}
+			currentIndex := ctx.SquashedDictionaryManager.CurrentAccessIndex()
+
+			delta := new(big.Int).SetUint64(newIndex)
+			delta.Sub(delta, new(big.Int).SetUint64(currentIndex))
+			delta.Sub(delta, big.NewInt(1))
+
+			ctx.SquashedDictionaryManager.SetCurrentAccessIndex(newIndex)
+
+			apAddr := vm.Context.AddressAp()
+			v := memory.MemoryValueFromFieldElement(bigIntToFelt(delta))
+			return vm.Memory.WriteToAddress(&apAddr, &v)
+		},
+	}
+	return h, nil
+}
+
+func createSquashDictInnerContinueLoopHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	h := &GenericZeroHinter{
+		Name: "SquashDictInnerContinueLoop",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> ids.loop_temps.should_continue = 1 if current_access_indices else 0
+			indices, err := ctx.SquashedDictionaryManager.LastIndices()
+			if err != nil {
+				return err
+			}
+			apAddr := vm.Context.AddressAp()
+			var v memory.MemoryValue
+			if len(indices) == 0 {
+				v = memory.MemoryValueFromFieldElement(&utils.FeltZero)
+			} else {
+				v = memory.MemoryValueFromFieldElement(&utils.FeltOne)
+			}
+			return vm.Memory.WriteToAddress(&apAddr, &v)
+		},
+	}
+	return h, nil
+}
+
+func createSquashDictInnerAssertLenKeysHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	h := &GenericZeroHinter{
+		Name: "SquashDictInnerAssertLenKeys",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> assert len(keys) == 0
+			if len(ctx.SquashedDictionaryManager.Keys) != 0 {
+				return fmt.Errorf("assertion failed: len(keys) == 0")
+			}
+			return nil
+		},
+	}
+	return h, nil
+}
+
+func createSquashDictInnerLenAssertHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	h := &GenericZeroHinter{
+		Name: "SquashDictInnerLenAssert",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> assert len(current_access_indices) == 0
+			indices, err := ctx.SquashedDictionaryManager.LastIndices()
+			if err == nil && len(indices) != 0 {
+				return fmt.Errorf("assertion failed: len(current_access_indices) == 0")
+			}
+			return nil
+		},
+	}
+	return h, nil
+}
+
+func createSquashDictInnerUsedAccessesAssertHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	nUsedAccesses, err := resolver.GetResOperander("n_used_accesses")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "SquashDictInnerUsedAccessesAssert",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> assert ids.n_used_accesses == len(access_indices[key])
+			key, err := ctx.SquashedDictionaryManager.LastKey()
+			if err != nil {
+				return err
+			}
+			value, err := nUsedAccesses.Resolve(vm)
+			if err != nil {
+				return err
+			}
+			felt, err := value.FieldElement()
+			if err != nil {
+				return err
+			}
+			used := uint64(felt.Uint64())
+			if used != ctx.SquashedDictionaryManager.AccessCount[key] {
+				return fmt.Errorf("assertion failed: n_used_accesses == len(access_indices[key])")
+			}
+			return nil
+		},
+	}
+	return h, nil
+}
+
+func createSquashDictInnerNextKeyHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	h := &GenericZeroHinter{
+		Name: "SquashDictInnerNextKey",
+		Op: func(vm *VM.VirtualMachine, ctx *hinter.HintRunnerContext) error {
+			//> assert len(keys) > 0, 'No keys left but remaining_accesses > 0.'
+			//> ids.next_key = key = keys.pop()
+			key, err := ctx.SquashedDictionaryManager.PopKey()
+			if err != nil {
+				return fmt.Errorf("no keys left but remaining_accesses > 0: %w", err)
+			}
+			apAddr := vm.Context.AddressAp()
+			v := memory.MemoryValueFromFieldElement(&key)
+			return vm.Memory.WriteToAddress(&apAddr, &v)
+		},
+	}
+	return h, nil
+}
@@ -0,0 +1,361 @@
+package zero
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/NethermindEth/cairo-vm-go/pkg/hintrunner/hinter"
+	"github.com/NethermindEth/cairo-vm-go/pkg/utils"
+	VM "github.com/NethermindEth/cairo-vm-go/pkg/vm"
+	"github.com/NethermindEth/cairo-vm-go/pkg/vm/memory"
+)
+
+const (
+	uint256AddCode            = "uint256_add"
+	uint256SubCode            = "uint256_sub"
+	uint256MulDivModCode      = "uint256_mul_div_mod"
+	uint256SqrtCode           = "uint256_sqrt"
+	uint256UnsignedDivRemCode = "uint256_unsigned_div_rem"
+	uint256SignedNNCode       = "uint256_signed_nn"
+)
+
+// uint256ToBig reassembles a `Uint256{low, high}` struct, as used throughout
+// starkware.cairo.common.uint256, into a single big.Int
+func uint256ToBig(low, high *big.Int) *big.Int {
+	v := new(big.Int).Lsh(high, 128)
+	return v.Or(v, low)
+}
+
+// splitUint256 is the inverse of uint256ToBig: it produces the {low, high}
+// limbs of a non-negative integer, wrapping if it does not fit in 256 bits
+func splitUint256(v *big.Int) (low, high *big.Int) {
+	mask := new(big.Int).Sub(shift128, big.NewInt(1))
+	low = new(big.Int).And(v, mask)
+	high = new(big.Int).Rsh(v, 128)
+	high.And(high, mask)
+	return low, high
+}
+
+func createUint256AddHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	aLow, err := resolver.GetResOperander("a_low")
+	if err != nil {
+		return nil, err
+	}
+	aHigh, err := resolver.GetResOperander("a_high")
+	if err != nil {
+		return nil, err
+	}
+	bLow, err := resolver.GetResOperander("b_low")
+	if err != nil {
+		return nil, err
+	}
+	bHigh, err := resolver.GetResOperander("b_high")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "Uint256Add",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> sum_low = ids.a.low + ids.b.low
+			//> ids.carry_low = 1 if sum_low >= ids.SHIFT else 0
+			//> sum_high = ids.a.high + ids.b.high + ids.carry_low
+			//> ids.carry_high = 1 if sum_high >= ids.SHIFT else 0
+			aLowBig, aHighBig, err := resolveBigPair(vm, aLow, aHigh)
+			if err != nil {
+				return err
+			}
+			bLowBig, bHighBig, err := resolveBigPair(vm, bLow, bHigh)
+			if err != nil {
+				return err
+			}
+
+			sumLow := new(big.Int).Add(aLowBig, bLowBig)
+			carryLow := big.NewInt(0)
+			if sumLow.Cmp(shift128) >= 0 {
+				carryLow = big.NewInt(1)
+			}
+
+			sumHigh := new(big.Int).Add(aHighBig, bHighBig)
+			sumHigh.Add(sumHigh, carryLow)
+			carryHigh := big.NewInt(0)
+			if sumHigh.Cmp(shift128) >= 0 {
+				carryHigh = big.NewInt(1)
+			}
+
+			return writeFeltsAtAp(vm, bigIntToFelt(carryLow), bigIntToFelt(carryHigh))
+		},
+	}
+	return h, nil
+}
+
+func createUint256SubHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	aLow, err := resolver.GetResOperander("a_low")
+	if err != nil {
+		return nil, err
+	}
+	aHigh, err := resolver.GetResOperander("a_high")
+	if err != nil {
+		return nil, err
+	}
+	bLow, err := resolver.GetResOperander("b_low")
+	if err != nil {
+		return nil, err
+	}
+	bHigh, err := resolver.GetResOperander("b_high")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "Uint256Sub",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> diff_low = ids.a.low - ids.b.low
+			//> ids.borrow_low = 1 if diff_low < 0 else 0
+			//> diff_high = ids.a.high - ids.b.high - ids.borrow_low
+			//> ids.borrow_high = 1 if diff_high < 0 else 0
+			aLowBig, aHighBig, err := resolveBigPair(vm, aLow, aHigh)
+			if err != nil {
+				return err
+			}
+			bLowBig, bHighBig, err := resolveBigPair(vm, bLow, bHigh)
+			if err != nil {
+				return err
+			}
+
+			diffLow := new(big.Int).Sub(aLowBig, bLowBig)
+			borrowLow := big.NewInt(0)
+			if diffLow.Sign() < 0 {
+				borrowLow = big.NewInt(1)
+			}
+
+			diffHigh := new(big.Int).Sub(aHighBig, bHighBig)
+			diffHigh.Sub(diffHigh, borrowLow)
+			borrowHigh := big.NewInt(0)
+			if diffHigh.Sign() < 0 {
+				borrowHigh = big.NewInt(1)
+			}
+
+			return writeFeltsAtAp(vm, bigIntToFelt(borrowLow), bigIntToFelt(borrowHigh))
+		},
+	}
+	return h, nil
+}
+
+func createUint256MulDivModHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	aLow, err := resolver.GetResOperander("a_low")
+	if err != nil {
+		return nil, err
+	}
+	aHigh, err := resolver.GetResOperander("a_high")
+	if err != nil {
+		return nil, err
+	}
+	bLow, err := resolver.GetResOperander("b_low")
+	if err != nil {
+		return nil, err
+	}
+	bHigh, err := resolver.GetResOperander("b_high")
+	if err != nil {
+		return nil, err
+	}
+	divLow, err := resolver.GetResOperander("div_low")
+	if err != nil {
+		return nil, err
+	}
+	divHigh, err := resolver.GetResOperander("div_high")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "Uint256MulDivMod",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> a = (ids.a.high << 128) + ids.a.low
+			//> b = (ids.b.high << 128) + ids.b.low
+			//> div = (ids.div.high << 128) + ids.div.low
+			//> quotient, remainder = divmod(a * b, div)
+			//> ids.quotient_low.low = quotient & ((1 << 128) - 1)
+			//> ids.quotient_low.high = (quotient >> 128) & ((1 << 128) - 1)
+			//> ids.quotient_high.low = (quotient >> 256) & ((1 << 128) - 1)
+			//> ids.quotient_high.high = quotient >> 384
+			//> ids.remainder.low = remainder & ((1 << 128) - 1)
+			//> ids.remainder.high = remainder >> 128
+			aLowBig, aHighBig, err := resolveBigPair(vm, aLow, aHigh)
+			if err != nil {
+				return err
+			}
+			bLowBig, bHighBig, err := resolveBigPair(vm, bLow, bHigh)
+			if err != nil {
+				return err
+			}
+			divLowBig, divHighBig, err := resolveBigPair(vm, divLow, divHigh)
+			if err != nil {
+				return err
+			}
+
+			a := uint256ToBig(aLowBig, aHighBig)
+			b := uint256ToBig(bLowBig, bHighBig)
+			div := uint256ToBig(divLowBig, divHighBig)
+			if div.Sign() == 0 {
+				return fmt.Errorf("uint256_mul_div_mod: division by zero")
+			}
+
+			product := new(big.Int).Mul(a, b)
+			quotient, remainder := new(big.Int), new(big.Int)
+			quotient.DivMod(product, div, remainder)
+
+			mask256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+			quotientLow := new(big.Int).And(quotient, mask256)
+			quotientHigh := new(big.Int).Rsh(quotient, 256)
+
+			quotientLowLow, quotientLowHigh := splitUint256(quotientLow)
+			quotientHighLow, quotientHighHigh := splitUint256(quotientHigh)
+			remainderLow, remainderHigh := splitUint256(remainder)
+
+			return writeFeltsAtAp(vm,
+				bigIntToFelt(quotientLowLow), bigIntToFelt(quotientLowHigh),
+				bigIntToFelt(quotientHighLow), bigIntToFelt(quotientHighHigh),
+				bigIntToFelt(remainderLow), bigIntToFelt(remainderHigh),
+			)
+		},
+	}
+	return h, nil
+}
+
+func createUint256SqrtHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	valueLow, err := resolver.GetResOperander("value_low")
+	if err != nil {
+		return nil, err
+	}
+	valueHigh, err := resolver.GetResOperander("value_high")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "Uint256Sqrt",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> from starkware.python.math_utils import isqrt
+			//> n = (ids.n.high << 128) + ids.n.low
+			//> root = isqrt(n)
+			//> assert 0 <= root < 2 ** 128
+			//> ids.root.low = root
+			//> ids.root.high = 0
+			lowBig, highBig, err := resolveBigPair(vm, valueLow, valueHigh)
+			if err != nil {
+				return err
+			}
+
+			n := uint256ToBig(lowBig, highBig)
+			root := new(big.Int).Sqrt(n)
+
+			return writeFeltsAtAp(vm, bigIntToFelt(root), bigIntToFelt(big.NewInt(0)))
+		},
+	}
+	return h, nil
+}
+
+func createUint256UnsignedDivRemHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	aLow, err := resolver.GetResOperander("a_low")
+	if err != nil {
+		return nil, err
+	}
+	aHigh, err := resolver.GetResOperander("a_high")
+	if err != nil {
+		return nil, err
+	}
+	divLow, err := resolver.GetResOperander("div_low")
+	if err != nil {
+		return nil, err
+	}
+	divHigh, err := resolver.GetResOperander("div_high")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "Uint256UnsignedDivRem",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> a = (ids.a.high << 128) + ids.a.low
+			//> div = (ids.div.high << 128) + ids.div.low
+			//> quotient, remainder = divmod(a, div)
+			//> ids.quotient.low = quotient & ((1 << 128) - 1)
+			//> ids.quotient.high = quotient >> 128
+			//> ids.remainder.low = remainder & ((1 << 128) - 1)
+			//> ids.remainder.high = remainder >> 128
+			aLowBig, aHighBig, err := resolveBigPair(vm, aLow, aHigh)
+			if err != nil {
+				return err
+			}
+			divLowBig, divHighBig, err := resolveBigPair(vm, divLow, divHigh)
+			if err != nil {
+				return err
+			}
+
+			a := uint256ToBig(aLowBig, aHighBig)
+			div := uint256ToBig(divLowBig, divHighBig)
+			if div.Sign() == 0 {
+				return fmt.Errorf("uint256_unsigned_div_rem: division by zero")
+			}
+
+			quotient, remainder := new(big.Int), new(big.Int)
+			quotient.DivMod(a, div, remainder)
+
+			quotientLow, quotientHigh := splitUint256(quotient)
+			remainderLow, remainderHigh := splitUint256(remainder)
+
+			return writeFeltsAtAp(vm,
+				bigIntToFelt(quotientLow), bigIntToFelt(quotientHigh),
+				bigIntToFelt(remainderLow), bigIntToFelt(remainderHigh),
+			)
+		},
+	}
+	return h, nil
+}
+
+func createUint256SignedNNHinter(resolver hintReferenceResolver) (hinter.Hinter, error) {
+	aHigh, err := resolver.GetResOperander("a_high")
+	if err != nil {
+		return nil, err
+	}
+
+	h := &GenericZeroHinter{
+		Name: "Uint256SignedNN",
+		Op: func(vm *VM.VirtualMachine, _ *hinter.HintRunnerContext) error {
+			//> memory[ap] = 1 if 0 <= (ids.a.high % PRIME) < 2 ** 127 else 0
+			aHighFelt, err := resolveFelt(vm, aHigh)
+			if err != nil {
+				return err
+			}
+			var aHighBig big.Int
+			aHighFelt.BigInt(&aHighBig)
+
+			upperBound := new(big.Int).Lsh(big.NewInt(1), 127)
+
+			apAddr := vm.Context.AddressAp()
+			var v = utils.FeltOne
+			if aHighBig.Cmp(upperBound) >= 0 {
+				v = utils.FeltZero
+			}
+			mv := memory.MemoryValueFromFieldElement(&v)
+			return vm.Memory.WriteToAddress(&apAddr, &mv)
+		},
+	}
+	return h, nil
+}
+
+func resolveBigPair(vm *VM.VirtualMachine, low, high hinter.ResOperander) (*big.Int, *big.Int, error) {
+	lowFelt, err := resolveFelt(vm, low)
+	if err != nil {
+		return nil, nil, err
+	}
+	highFelt, err := resolveFelt(vm, high)
+	if err != nil {
+		return nil, nil, err
+	}
+	var lowBig, highBig big.Int
+	lowFelt.BigInt(&lowBig)
+	highFelt.BigInt(&highBig)
+	return &lowBig, &highBig, nil
+}
@@ -0,0 +1,43 @@
+package zero
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestUint256ToBig(t *testing.T) {
+	low := big.NewInt(7)
+	high := big.NewInt(3)
+
+	got := uint256ToBig(low, high)
+
+	want := new(big.Int).Lsh(high, 128)
+	want.Or(want, low)
+	if got.Cmp(want) != 0 {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+}
+
+func TestSplitUint256_RoundTrip(t *testing.T) {
+	low := big.NewInt(123)
+	high := big.NewInt(456)
+	combined := uint256ToBig(low, high)
+
+	gotLow, gotHigh := splitUint256(combined)
+	if gotLow.Cmp(low) != 0 {
+		t.Fatalf("expected low %s, got %s", low, gotLow)
+	}
+	if gotHigh.Cmp(high) != 0 {
+		t.Fatalf("expected high %s, got %s", high, gotHigh)
+	}
+}
+
+func TestSplitUint256_WrapsAbove256Bits(t *testing.T) {
+	// 2**256 should wrap to {low: 0, high: 0}, the same as splitting 0
+	overflowing := new(big.Int).Lsh(big.NewInt(1), 256)
+
+	low, high := splitUint256(overflowing)
+	if low.Sign() != 0 || high.Sign() != 0 {
+		t.Fatalf("expected {0, 0}, got {%s, %s}", low, high)
+	}
+}